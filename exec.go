@@ -1,6 +1,7 @@
 package main
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"os/exec"
@@ -10,8 +11,12 @@ import (
 )
 
 // execInOrder runs the given command in each directory in the provided order,
-// honoring maxParallel AND dependency relationships
-func execInOrder(order []string, edges []Edge, execCmd string, maxParallel int) error {
+// honoring maxParallel AND dependency relationships. Each run is appended to
+// the structured log at <startDir>/.tforder/log.rec. When incremental is
+// true, a directory is skipped if its content-hash stamp (see stamp.go)
+// matches the stamp recorded on disk and lastLog shows its last run exited
+// 0; otherwise it runs and the stamp is refreshed on success.
+func execInOrder(order []string, edges []Edge, execCmd string, maxParallel int, startDir string, incremental bool, lastLog map[string]execRecord) error {
 	// Build actual dependency map from the original edges
 	dependencies := make(map[string]map[string]bool)
 	dependents := make(map[string]map[string]bool)
@@ -40,8 +45,10 @@ func execInOrder(order []string, edges []Edge, execCmd string, maxParallel int)
 	// Semaphore to limit concurrency
 	sem := make(chan struct{}, maxParallel)
 
-	// Track completed nodes
+	// Track completed nodes, and (when incremental) the stamp each one ran
+	// with, so dependents can fold it into their own stamp computation.
 	completedNodes := make(map[string]bool)
+	nodeStamps := make(map[string]string)
 	var completedMutex sync.Mutex
 
 	// First, determine nodes with no dependencies (they can start immediately)
@@ -112,12 +119,83 @@ func execInOrder(order []string, edges []Edge, execCmd string, maxParallel int)
 						}
 						completedMutex.Unlock()
 
+						rel := relOrBase(startDir, directory)
+
+						var stamp string
+						stampOK := false
+						if incremental {
+							completedMutex.Lock()
+							var depStamps []string
+							for dep := range dependencies[directory] {
+								depStamps = append(depStamps, nodeStamps[dep])
+							}
+							completedMutex.Unlock()
+
+							s, serr := computeStamp(directory, depStamps, execCmd)
+							if serr != nil {
+								fmt.Printf("[%s] Failed to compute stamp: %v\n", filepath.Base(directory), serr)
+							} else {
+								stamp, stampOK = s, true
+								prev, havePrev := readStamp(startDir, rel)
+								rec, haveRec := lastLog[rel]
+								if havePrev && prev == stamp && haveRec && rec.ExitCode == 0 {
+									fmt.Printf("[tforder] Skipping %s (up to date)\n", rel)
+									completedMutex.Lock()
+									nodeStamps[directory] = stamp
+									completedMutex.Unlock()
+									completed <- directory
+									return
+								}
+							}
+						}
+
 						// Execute the command
 						fmt.Printf("[tforder] Running in %s: %s\n", directory, execCmd)
 						cmd := exec.CommandContext(ctx, "/bin/sh", "-c", execCmd)
 						cmd.Dir = directory
-						out, err := cmd.CombinedOutput()
-						fmt.Printf("[%s] Output:\n%s", filepath.Base(directory), out)
+						var stdout, stderr bytes.Buffer
+						cmd.Stdout = &stdout
+						cmd.Stderr = &stderr
+						started := time.Now()
+						err := cmd.Run()
+						finished := time.Now()
+						fmt.Printf("[%s] Output:\n%s%s", filepath.Base(directory), stdout.String(), stderr.String())
+
+						exitCode := 0
+						if err != nil {
+							exitCode = 1
+							if exitErr, ok := err.(*exec.ExitError); ok {
+								exitCode = exitErr.ExitCode()
+							}
+						}
+						if logErr := appendLogRecord(startDir, execRecord{
+							Target:     rel,
+							Started:    started,
+							Finished:   finished,
+							ExitCode:   exitCode,
+							Cmd:        execCmd,
+							DurationMs: finished.Sub(started).Milliseconds(),
+							Stdout:     stdout.String(),
+							Stderr:     stderr.String(),
+						}); logErr != nil {
+							fmt.Printf("[%s] Failed to write log record: %v\n", filepath.Base(directory), logErr)
+						}
+
+						if incremental {
+							if stampOK && err == nil {
+								if werr := writeStamp(startDir, rel, stamp); werr != nil {
+									fmt.Printf("[%s] Failed to write stamp: %v\n", filepath.Base(directory), werr)
+								}
+							} else if !stampOK {
+								// computeStamp failed: record a stamp that can
+								// never match a future run, so dependents never
+								// treat this directory's content as validated.
+								stamp = fmt.Sprintf("unresolved-%d", time.Now().UnixNano())
+							}
+							completedMutex.Lock()
+							nodeStamps[directory] = stamp
+							completedMutex.Unlock()
+						}
 
 						if err != nil {
 							fmt.Printf("[%s] Error: %v\n", filepath.Base(directory), err)
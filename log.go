@@ -0,0 +1,143 @@
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// execRecord is a single append-only entry in the structured execution log
+// kept at <startDir>/.tforder/log.rec.
+type execRecord struct {
+	Target     string
+	Started    time.Time
+	Finished   time.Time
+	ExitCode   int
+	Cmd        string
+	DurationMs int64
+	Stdout     string
+	Stderr     string
+}
+
+func logFilePath(startDir string) string {
+	return filepath.Join(startDir, ".tforder", "log.rec")
+}
+
+// appendLogRecord appends rec to <startDir>/.tforder/log.rec in recfile
+// format (the format goredo uses for its own build log): records are
+// separated by a blank line, each field is a "Key: value" line, and
+// multi-line values are continued with "+ " prefixed lines.
+func appendLogRecord(startDir string, rec execRecord) error {
+	dir := filepath.Dir(logFilePath(startDir))
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return fmt.Errorf("failed to create log dir: %w", err)
+	}
+	f, err := os.OpenFile(logFilePath(startDir), os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o644)
+	if err != nil {
+		return fmt.Errorf("failed to open log.rec: %w", err)
+	}
+	defer f.Close()
+
+	w := bufio.NewWriter(f)
+	writeRecField(w, "Target", rec.Target)
+	writeRecField(w, "Started", rec.Started.Format(time.RFC3339Nano))
+	writeRecField(w, "Finished", rec.Finished.Format(time.RFC3339Nano))
+	writeRecField(w, "ExitCode", strconv.Itoa(rec.ExitCode))
+	writeRecField(w, "Cmd", rec.Cmd)
+	writeRecField(w, "DurationMs", strconv.FormatInt(rec.DurationMs, 10))
+	writeRecField(w, "Stdout", rec.Stdout)
+	writeRecField(w, "Stderr", rec.Stderr)
+	fmt.Fprintln(w)
+	return w.Flush()
+}
+
+// writeRecField writes a single recfile field, continuing subsequent lines
+// of a multi-line value with "+ ".
+func writeRecField(w *bufio.Writer, key, value string) {
+	lines := strings.Split(value, "\n")
+	fmt.Fprintf(w, "%s: %s\n", key, lines[0])
+	for _, l := range lines[1:] {
+		fmt.Fprintf(w, "+ %s\n", l)
+	}
+}
+
+// readLogRecords parses every record in <startDir>/.tforder/log.rec. A
+// missing log file is not an error; it just means no run has happened yet.
+func readLogRecords(startDir string) ([]execRecord, error) {
+	f, err := os.Open(logFilePath(startDir))
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to open log.rec: %w", err)
+	}
+	defer f.Close()
+
+	var records []execRecord
+	var rec execRecord
+	var field *string
+
+	flush := func() {
+		if rec.Target != "" {
+			records = append(records, rec)
+		}
+		rec = execRecord{}
+		field = nil
+	}
+
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if line == "" {
+			flush()
+			continue
+		}
+		if field != nil && strings.HasPrefix(line, "+ ") {
+			*field += "\n" + strings.TrimPrefix(line, "+ ")
+			continue
+		}
+		key, value, ok := strings.Cut(line, ": ")
+		if !ok {
+			continue
+		}
+		switch key {
+		case "Target":
+			rec.Target = value
+			field = &rec.Target
+		case "Started":
+			rec.Started, _ = time.Parse(time.RFC3339Nano, value)
+		case "Finished":
+			rec.Finished, _ = time.Parse(time.RFC3339Nano, value)
+		case "ExitCode":
+			rec.ExitCode, _ = strconv.Atoi(value)
+		case "Cmd":
+			rec.Cmd = value
+			field = &rec.Cmd
+		case "DurationMs":
+			rec.DurationMs, _ = strconv.ParseInt(value, 10, 64)
+		case "Stdout":
+			rec.Stdout = value
+			field = &rec.Stdout
+		case "Stderr":
+			rec.Stderr = value
+			field = &rec.Stderr
+		}
+	}
+	flush()
+	return records, scanner.Err()
+}
+
+// lastPerTarget reduces records to the most recent entry per Target. Since
+// the log is append-only, the last occurrence in file order wins.
+func lastPerTarget(records []execRecord) map[string]execRecord {
+	last := map[string]execRecord{}
+	for _, r := range records {
+		last[r.Target] = r
+	}
+	return last
+}
@@ -0,0 +1,53 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// writeNinjaFile writes a build.ninja file describing the dependency graph.
+// Each directory in order becomes a build edge whose output is a
+// per-directory stamp file under buildDir and whose inputs are the stamp
+// files of its direct dependencies, so `ninja` can drive the fleet with its
+// own scheduling, restart-on-failure and -j parallelism instead of
+// reimplementing that here. execCmd is used as the apply command for every
+// edge; if empty, each edge falls back to `terraform apply -auto-approve`.
+func writeNinjaFile(edges []Edge, order []string, outPath, root, buildDir, execCmd string) error {
+	f, err := os.Create(outPath)
+	if err != nil {
+		return fmt.Errorf("failed to create .ninja file: %w", err)
+	}
+	defer f.Close()
+
+	deps := map[string][]string{}
+	for _, e := range edges {
+		deps[e.Target] = append(deps[e.Target], e.Source)
+	}
+
+	stamp := func(dir string) string {
+		return filepath.ToSlash(filepath.Join(buildDir, relOrBase(root, dir)+".stamp"))
+	}
+
+	fmt.Fprintln(f, "rule apply")
+	fmt.Fprintln(f, "  command = ( cd $dir && $cmd ) && mkdir -p $$(dirname $out) && touch $out")
+	fmt.Fprintln(f, "  description = apply $dir")
+	fmt.Fprintln(f)
+
+	for _, n := range order {
+		cmd := execCmd
+		if cmd == "" {
+			cmd = "terraform apply -auto-approve"
+		}
+		fmt.Fprintf(f, "build %s: apply", stamp(n))
+		for _, d := range deps[n] {
+			fmt.Fprintf(f, " %s", stamp(d))
+		}
+		fmt.Fprintln(f)
+		fmt.Fprintf(f, "  dir = %s\n", n)
+		fmt.Fprintf(f, "  cmd = %s\n", cmd)
+		fmt.Fprintln(f)
+	}
+
+	return nil
+}
@@ -1,11 +1,12 @@
 package main
 
 import (
-	"bufio"
-	"os"
 	"path/filepath"
-	"regexp"
-	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclparse"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/zclconf/go-cty/cty"
 )
 
 // collectEdges recursively collects dependency edges starting from dir
@@ -24,42 +25,63 @@ func collectEdges(dir string, edges *[]Edge, visited map[string]bool) {
 	}
 }
 
-// parseDependencies parses the dependencies block from a main.tf file
+// parseDependencies parses the `locals { dependencies = { ... } }` block
+// from a main.tf file using a real HCL parser, so comments, `}` inside
+// string values, multi-line strings and heredocs are handled correctly
+// rather than pattern-matched line by line. Values are evaluated against a
+// limited hcl.EvalContext exposing only `path.module` and `path.root`, so
+// Terraform-style interpolations like "${path.module}/../vpc" resolve to
+// the directory containing tfPath.
 func parseDependencies(tfPath string) map[string]string {
-	file, err := os.Open(tfPath)
-	if err != nil {
-		return map[string]string{}
+	deps := map[string]string{}
+
+	parser := hclparse.NewParser()
+	f, diags := parser.ParseHCLFile(tfPath)
+	if diags.HasErrors() || f == nil {
+		return deps
+	}
+	body, ok := f.Body.(*hclsyntax.Body)
+	if !ok {
+		return deps
 	}
-	defer file.Close()
 
-	deps := map[string]string{}
-	inLocals := false
-	inDeps := false
-	depRe := regexp.MustCompile(`(?i)\s*([a-zA-Z0-9_\-]+)\s*=\s*"([^"]+)"`)
+	// Both callers (collectEdges and the -recursive walk in tforder.go)
+	// re-join the returned path onto the directory containing tfPath, so
+	// path.module/path.root must resolve to "." here rather than to the
+	// directory's absolute path - otherwise a value like
+	// "${path.module}/../vpc" would get joined onto dir twice.
+	evalCtx := &hcl.EvalContext{
+		Variables: map[string]cty.Value{
+			"path": cty.ObjectVal(map[string]cty.Value{
+				"module": cty.StringVal("."),
+				"root":   cty.StringVal("."),
+			}),
+		},
+	}
 
-	scanner := bufio.NewScanner(file)
-	for scanner.Scan() {
-		line := strings.TrimSpace(scanner.Text())
-		if strings.HasPrefix(line, "locals") && strings.Contains(line, "{") {
-			inLocals = true
+	for _, block := range body.Blocks {
+		if block.Type != "locals" {
 			continue
 		}
-		if inLocals && strings.HasPrefix(line, "dependencies") && strings.Contains(line, "{") {
-			inDeps = true
+		attrs, diags := block.Body.JustAttributes()
+		if diags.HasErrors() {
 			continue
 		}
-		if inDeps {
-			if strings.Contains(line, "}") {
-				inDeps = false
+		attr, ok := attrs["dependencies"]
+		if !ok {
+			continue
+		}
+		val, diags := attr.Expr.Value(evalCtx)
+		if diags.HasErrors() || val.IsNull() || !val.CanIterateElements() {
+			continue
+		}
+		it := val.ElementIterator()
+		for it.Next() {
+			k, v := it.Element()
+			if v.Type() != cty.String {
 				continue
 			}
-			matches := depRe.FindStringSubmatch(line)
-			if len(matches) == 3 {
-				deps[matches[1]] = matches[2]
-			}
-		}
-		if inLocals && !inDeps && strings.Contains(line, "}") {
-			inLocals = false
+			deps[k.AsString()] = v.AsString()
 		}
 	}
 	return deps
@@ -0,0 +1,88 @@
+package main
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+func stampCacheDir(startDir string) string {
+	return filepath.Join(startDir, ".tforder", "stamps")
+}
+
+func stampPath(startDir, rel string) string {
+	return filepath.Join(stampCacheDir(startDir), rel+".stamp")
+}
+
+// computeStamp hashes a directory's own *.tf/*.tfvars files together with
+// the stamps of its direct dependencies and the command that will be run,
+// so that any change to the directory, its inputs, or the command
+// invalidates the cache.
+func computeStamp(dir string, depStamps []string, execCmd string) (string, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return "", fmt.Errorf("failed to read %s: %w", dir, err)
+	}
+	var names []string
+	for _, e := range entries {
+		if e.IsDir() {
+			continue
+		}
+		n := e.Name()
+		if strings.HasSuffix(n, ".tf") || strings.HasSuffix(n, ".tfvars") {
+			names = append(names, n)
+		}
+	}
+	sort.Strings(names)
+
+	h := sha256.New()
+	for _, n := range names {
+		b, err := os.ReadFile(filepath.Join(dir, n))
+		if err != nil {
+			return "", fmt.Errorf("failed to read %s: %w", n, err)
+		}
+		fmt.Fprintf(h, "file:%s\n", n)
+		h.Write(b)
+	}
+
+	sortedDeps := append([]string(nil), depStamps...)
+	sort.Strings(sortedDeps)
+	for _, s := range sortedDeps {
+		fmt.Fprintf(h, "dep:%s\n", s)
+	}
+	fmt.Fprintf(h, "cmd:%s\n", execCmd)
+
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// readStamp returns the stamp previously recorded for rel, if any.
+func readStamp(startDir, rel string) (string, bool) {
+	b, err := os.ReadFile(stampPath(startDir, rel))
+	if err != nil {
+		return "", false
+	}
+	return strings.TrimSpace(string(b)), true
+}
+
+// writeStamp records stamp as the current content hash for rel.
+func writeStamp(startDir, rel, stamp string) error {
+	path := stampPath(startDir, rel)
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return fmt.Errorf("failed to create stamp dir: %w", err)
+	}
+	return os.WriteFile(path, []byte(stamp+"\n"), 0o644)
+}
+
+// invalidateStamp removes the stamp recorded for rel, if any, forcing the
+// next -incremental run to re-execute that directory.
+func invalidateStamp(startDir, rel string) error {
+	err := os.Remove(stampPath(startDir, rel))
+	if err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("failed to remove stamp for %s: %w", rel, err)
+	}
+	return nil
+}
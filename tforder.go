@@ -2,19 +2,32 @@
 tforder: Terraform dependency graph generator
 
 Usage:
-  tforder -d <start_dir> [-o <file.{txt|.dot|.svg|.png}>] [-r] [-relative-to <base>]
+  tforder -d <start_dir> [-o <file.{txt|.dot|.svg|.png|.ninja}>] [-r] [-relative-to <base>]
 
 Flags:
   -d, -dir           Directory to start in (default: .)
-  -o, -out           Output file (.txt, .dot, .svg, .png). If not specified, output is printed to stdout in numbered list format.
+  -o, -out           Output file (.txt, .dot, .svg, .png, .ninja). If not specified, output is printed to stdout in numbered list format.
   -r, -recursive     Recursively scan all subdirectories for main.tf files
   -relative-to   Base path for relative node names (default: current working directory)
+  -ninja-dir     Build directory for .ninja stamp files (default: .tforder/ninja-stamps)
+  -log           Show the most recent execution log record per target
+  -depth N       With -log, indent targets by graph depth, optionally limited to depth N
+  -a, -affects <dir>  Only show/execute directories that transitively depend on <dir>
+  -incremental   With -x, skip a directory whose content-hash stamp is unchanged and last run exited 0
+  -force <dir>   Invalidate the -incremental stamp for <dir> and everything that depends on it
 
 Examples:
   tforder -d tf/dev/eu-west-1/ew1a/eks
   tforder -d tf/dev/eu-west-1/ew1a/eks -o order.svg
   tforder -d tf -r -o infra.dot
   tforder -d tf -r -o infra.svg -relative-to tf
+  tforder -d tf -r -o infra.ninja && ninja -f infra.ninja -j 8
+  tforder -d tf -r -x 'terraform apply -auto-approve'
+  tforder -d tf -r -log -depth 2
+  tforder -d tf -r -affects tf/modules/vpc
+  tforder -d tf -r -a tf/modules/vpc -x 'terraform apply -auto-approve'
+  tforder -d tf -r -incremental -x 'terraform apply -auto-approve'
+  tforder -d tf -r -force tf/modules/vpc
 */
 
 package main
@@ -27,6 +40,7 @@ import (
 	"os/exec"
 	"path/filepath"
 	"strings"
+	"time"
 )
 
 type Edge struct {
@@ -41,6 +55,7 @@ func main() {
 		"-o": "-out",
 		"-r": "-recursive",
 		"-x": "-execute",
+		"-a": "-affects",
 	}
 	osArgs := os.Args[:1]
 	for i := 1; i < len(os.Args); i++ {
@@ -56,10 +71,16 @@ func main() {
 	dirPtr := flag.String("dir", ".", "-d, -dir  Directory to start in (default: .)")
 	outPtr := flag.String("out", "", "-o, -out  Output file (.txt, .dot, .svg, .png). If not specified, output is printed to stdout in numbered list format.")
 	relToPtr := flag.String("relative-to", "", "Base path for relative node names (default: current working directory)")
+	ninjaDirPtr := flag.String("ninja-dir", ".tforder/ninja-stamps", "Build directory for .ninja stamp files")
 	recursivePtr := flag.Bool("recursive", false, "-r, -recursive  Recursively scan all subdirectories for main.tf files")
 	execPtr := flag.String("execute", "", "-x, -execute  Script or command to execute in each dependency directory (optional)")
 	maxParPtr := flag.Int("maxparallel", 2, "Maximum number of parallel executions (default 2)")
 	reversePtr := flag.Bool("reverse", false, "Reverse dependency order (for destroy operations)")
+	logPtr := flag.Bool("log", false, "-log  Show the most recent execution log record per target")
+	depthPtr := flag.Int("depth", -1, "With -log, indent targets by graph depth, optionally limited to depth N")
+	affectsPtr := flag.String("affects", "", "-a, -affects <dir>  Print (or execute) only the directories that transitively depend on <dir>, in apply order")
+	incrementalPtr := flag.Bool("incremental", false, "With -x, skip a directory whose content-hash stamp is unchanged and last run exited 0")
+	forcePtr := flag.String("force", "", "-force <dir>  Invalidate the -incremental stamp for <dir> and everything that depends on it")
 	flag.Parse()
 
 	startDir, _ := filepath.Abs(*dirPtr)
@@ -121,11 +142,88 @@ func main() {
 		}
 	}
 
+	if *forcePtr != "" {
+		forcedDir, err := filepath.Abs(*forcePtr)
+		if err != nil {
+			log.Fatalf("Invalid -force directory: %v", err)
+		}
+		adj := buildAdjacency(edges)
+		toInvalidate := reachableFrom(adj, forcedDir)
+		toInvalidate[forcedDir] = struct{}{}
+		for n := range toInvalidate {
+			if err := invalidateStamp(startDir, relOrBase(startDir, n)); err != nil {
+				log.Fatalf("Failed to invalidate stamp: %v", err)
+			}
+		}
+		fmt.Printf("Invalidated %d stamp(s) for %s and its dependents.\n", len(toInvalidate), relOrBase(writeBase, forcedDir))
+	}
+
+	var affected map[string]struct{}
+	if *affectsPtr != "" {
+		changedDir, err := filepath.Abs(*affectsPtr)
+		if err != nil {
+			log.Fatalf("Invalid -affects directory: %v", err)
+		}
+		adj := buildAdjacency(edges)
+		affected = reachableFrom(adj, changedDir)
+		affected[changedDir] = struct{}{}
+
+		var subEdges []Edge
+		for _, e := range edges {
+			if _, ok := affected[e.Source]; !ok {
+				continue
+			}
+			if _, ok := affected[e.Target]; !ok {
+				continue
+			}
+			subEdges = append(subEdges, e)
+		}
+		edges = subEdges
+	}
+
 	// Calculate topological order once after edges are built
 	order, err := topoSort(edges, *reversePtr)
 	if err != nil {
 		log.Fatalf("Failed to sort dependencies: %v", err)
 	}
+	if len(affected) > 0 {
+		inOrder := map[string]struct{}{}
+		for _, n := range order {
+			inOrder[n] = struct{}{}
+		}
+		for n := range affected {
+			if _, ok := inOrder[n]; !ok {
+				order = append(order, n)
+			}
+		}
+	}
+
+	if *logPtr {
+		records, err := readLogRecords(startDir)
+		if err != nil {
+			log.Fatalf("Failed to read execution log: %v", err)
+		}
+		last := lastPerTarget(records)
+		depths := computeDepths(order, edges)
+		for _, n := range order {
+			rel := relOrBase(startDir, n)
+			if *depthPtr >= 0 && depths[n] > *depthPtr {
+				continue
+			}
+			indent := ""
+			if *depthPtr >= 0 {
+				indent = strings.Repeat("  ", depths[n])
+			}
+			display := relOrBase(writeBase, n)
+			rec, ok := last[rel]
+			if !ok {
+				fmt.Printf("%s%s: (no run recorded)\n", indent, display)
+				continue
+			}
+			fmt.Printf("%s%s: exit %d, %dms, finished %s\n", indent, display, rec.ExitCode, rec.DurationMs, rec.Finished.Format(time.RFC3339))
+		}
+		return
+	}
 
 	if *execPtr != "" {
 		fmt.Printf("Execution order (reverse=%v):\n", *reversePtr)
@@ -139,7 +237,15 @@ func main() {
 				return "dependency order"
 			}
 		}(), *maxParPtr)
-		err = execInOrder(order, *execPtr, *maxParPtr)
+		var lastLog map[string]execRecord
+		if *incrementalPtr {
+			records, err := readLogRecords(startDir)
+			if err != nil {
+				log.Fatalf("Failed to read execution log: %v", err)
+			}
+			lastLog = lastPerTarget(records)
+		}
+		err = execInOrder(order, edges, *execPtr, *maxParPtr, startDir, *incrementalPtr, lastLog)
 		if err != nil {
 			log.Fatalf("Execution failed: %v", err)
 		}
@@ -149,7 +255,9 @@ func main() {
 
 	outFile := *outPtr
 	pretty := strings.HasSuffix(outFile, ".svg") || strings.HasSuffix(outFile, ".png")
-	isTxt := strings.HasSuffix(outFile, ".txt") || (!strings.HasSuffix(outFile, ".dot") && !pretty)
+	isDot := strings.HasSuffix(outFile, ".dot")
+	isNinja := strings.HasSuffix(outFile, ".ninja")
+	isTxt := strings.HasSuffix(outFile, ".txt") || (!isDot && !isNinja && !pretty)
 
 	if outFile == "" {
 		err := writeNumberedListWriterOrder(order, os.Stdout, writeBase)
@@ -162,6 +270,12 @@ func main() {
 			log.Fatalf("%v", err)
 		}
 		fmt.Printf("Numbered list written: %s\n", outFile)
+	} else if isNinja {
+		err := writeNinjaFile(edges, order, outFile, writeBase, *ninjaDirPtr, *execPtr)
+		if err != nil {
+			log.Fatalf("%v", err)
+		}
+		fmt.Printf("Ninja build file written: %s\n", outFile)
 	} else if pretty {
 		edgeSet := map[[2]string]struct{}{}
 		for i := 0; i < len(order)-1; i++ {
@@ -1,8 +1,10 @@
 package main
 
 import (
+	"container/heap"
 	"fmt"
 	"path/filepath"
+	"sort"
 	"strings"
 )
 
@@ -25,6 +27,24 @@ func escapeDotLabel(s string) string {
 	return strings.ReplaceAll(s, "\"", "\\\"")
 }
 
+// stringHeap is a min-heap of node path strings. Using it as topoSort's
+// ready queue, instead of a FIFO, makes the emitted order deterministic
+// across runs regardless of Go's random map iteration order - the same
+// approach Go's own compiler uses to order package initialization.
+type stringHeap []string
+
+func (h stringHeap) Len() int            { return len(h) }
+func (h stringHeap) Less(i, j int) bool  { return h[i] < h[j] }
+func (h stringHeap) Swap(i, j int)       { h[i], h[j] = h[j], h[i] }
+func (h *stringHeap) Push(x interface{}) { *h = append(*h, x.(string)) }
+func (h *stringHeap) Pop() interface{} {
+	old := *h
+	n := len(old)
+	x := old[n-1]
+	*h = old[:n-1]
+	return x
+}
+
 // Topological sort for dependency ordering
 func topoSort(edges []Edge, reverse bool) ([]string, error) {
 	// If reverse is true, reverse the edges before doing the topological sort
@@ -45,30 +65,185 @@ func topoSort(edges []Edge, reverse bool) ([]string, error) {
 		nodes[e.Source] = struct{}{}
 		nodes[e.Target] = struct{}{}
 	}
-	var order []string
-	queue := []string{}
+
+	ready := &stringHeap{}
 	for n := range nodes {
 		if inDegree[n] == 0 {
-			queue = append(queue, n)
+			*ready = append(*ready, n)
 		}
 	}
-	for len(queue) > 0 {
-		n := queue[0]
-		queue = queue[1:]
+	heap.Init(ready)
+
+	var order []string
+	for ready.Len() > 0 {
+		n := heap.Pop(ready).(string)
 		order = append(order, n)
 		for _, m := range adj[n] {
 			inDegree[m]--
 			if inDegree[m] == 0 {
-				queue = append(queue, m)
+				heap.Push(ready, m)
 			}
 		}
 	}
 	if len(order) != len(nodes) {
-		return nil, fmt.Errorf("cycle detected in dependency graph")
+		return nil, cycleError(nodes, inDegree, adj)
 	}
 	return order, nil
 }
 
+// cycleError runs Tarjan's strongly connected components algorithm over the
+// nodes that never reached zero in-degree (the ones still involved in a
+// cycle) and returns an error listing every SCC of size >= 2, with the
+// participating directories and the edges between them, so operators can
+// actually locate the offending `dependencies = {}` entries.
+func cycleError(nodes map[string]struct{}, inDegree map[string]int, adj map[string][]string) error {
+	stuck := map[string]struct{}{}
+	for n := range nodes {
+		if inDegree[n] > 0 {
+			stuck[n] = struct{}{}
+		}
+	}
+
+	index := map[string]int{}
+	lowlink := map[string]int{}
+	onStack := map[string]bool{}
+	var stack []string
+	counter := 0
+	var sccs [][]string
+
+	var strongconnect func(v string)
+	strongconnect = func(v string) {
+		index[v] = counter
+		lowlink[v] = counter
+		counter++
+		stack = append(stack, v)
+		onStack[v] = true
+
+		for _, w := range adj[v] {
+			if _, ok := stuck[w]; !ok {
+				continue
+			}
+			if _, visited := index[w]; !visited {
+				strongconnect(w)
+				if lowlink[w] < lowlink[v] {
+					lowlink[v] = lowlink[w]
+				}
+			} else if onStack[w] {
+				if index[w] < lowlink[v] {
+					lowlink[v] = index[w]
+				}
+			}
+		}
+
+		if lowlink[v] == index[v] {
+			var scc []string
+			for {
+				n := len(stack) - 1
+				w := stack[n]
+				stack = stack[:n]
+				onStack[w] = false
+				scc = append(scc, w)
+				if w == v {
+					break
+				}
+			}
+			if len(scc) >= 2 {
+				sccs = append(sccs, scc)
+			}
+		}
+	}
+
+	stuckSorted := make([]string, 0, len(stuck))
+	for v := range stuck {
+		stuckSorted = append(stuckSorted, v)
+	}
+	sort.Strings(stuckSorted)
+	for _, v := range stuckSorted {
+		if _, visited := index[v]; !visited {
+			strongconnect(v)
+		}
+	}
+
+	for _, scc := range sccs {
+		sort.Strings(scc)
+	}
+	sort.Slice(sccs, func(i, j int) bool { return sccs[i][0] < sccs[j][0] })
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "cycle detected in dependency graph (%d strongly connected component(s)):\n", len(sccs))
+	for _, scc := range sccs {
+		members := map[string]struct{}{}
+		for _, n := range scc {
+			members[n] = struct{}{}
+		}
+		fmt.Fprintf(&b, "  cycle: %s\n", strings.Join(scc, ", "))
+		// adj[n] holds n's dependents (Edge.Source is the dependency,
+		// Edge.Target the dependent), so m in adj[n] means "m depends on n".
+		for _, n := range scc {
+			dependents := append([]string(nil), adj[n]...)
+			sort.Strings(dependents)
+			for _, m := range dependents {
+				if _, ok := members[m]; ok {
+					fmt.Fprintf(&b, "    %s depends on %s\n", m, n)
+				}
+			}
+		}
+	}
+	return fmt.Errorf("%s", b.String())
+}
+
+// computeDepths returns, for each node in order, its longest dependency
+// chain length (roots that depend on nothing are depth 0). order is
+// expected to be topologically sorted so that every dependency of a node
+// has already had its depth computed by the time that node is visited.
+func computeDepths(order []string, edges []Edge) map[string]int {
+	deps := map[string][]string{}
+	for _, e := range edges {
+		deps[e.Target] = append(deps[e.Target], e.Source)
+	}
+	depth := map[string]int{}
+	for _, n := range order {
+		d := 0
+		for _, dep := range deps[n] {
+			if depth[dep]+1 > d {
+				d = depth[dep] + 1
+			}
+		}
+		depth[n] = d
+	}
+	return depth
+}
+
+// buildAdjacency returns the forward adjacency list (Source -> Targets) for
+// edges, i.e. for each directory the directories that depend on it.
+func buildAdjacency(edges []Edge) map[string][]string {
+	adj := map[string][]string{}
+	for _, e := range edges {
+		adj[e.Source] = append(adj[e.Source], e.Target)
+	}
+	return adj
+}
+
+// reachableFrom returns the set of nodes reachable from start by following
+// adj, not including start itself. Given the forward adjacency from
+// buildAdjacency, this is the set of directories that transitively depend
+// on start.
+func reachableFrom(adj map[string][]string, start string) map[string]struct{} {
+	visited := map[string]struct{}{}
+	queue := []string{start}
+	for len(queue) > 0 {
+		n := queue[0]
+		queue = queue[1:]
+		for _, m := range adj[n] {
+			if _, ok := visited[m]; !ok {
+				visited[m] = struct{}{}
+				queue = append(queue, m)
+			}
+		}
+	}
+	return visited
+}
+
 // reverseEdges creates a new slice of edges with Source and Target swapped
 func reverseEdges(edges []Edge) []Edge {
 	reversed := make([]Edge, len(edges))